@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"time"
@@ -15,41 +16,44 @@ func main() {
 
 	endpoints := []string{"localhost:8181", "localhost:8182", "localhost:8183"}
 	lb := loadbalancer.NewRoundRobinClientRemoteConnection(endpoints, "g", 10*time.Second, logger)
-	defer lb.Close()
+	if err := lb.Start(context.Background()); err != nil {
+		logger.Fatalf("failed to start load-balancer: %v", err)
+	}
+	defer lb.Stop()
 
 	{
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("User").Property("userId", "U1").Property("name", "Alice").Property("age", 30).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("User").Property("userId", "U2").Property("name", "Bob").Property("age", 25).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("User").Property("userId", "U3").Property("name", "Charlie").Property("age", 35).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("Account").Property("accountId", "A1").Property("balance", 1000).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("Account").Property("accountId", "A2").Property("balance", 500).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("Account").Property("accountId", "A3").Property("balance", 750).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 
 		_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
 			return g.V().Has("userId", "U1").As("u").V().Has("accountId", "A1").
 				AddE("owns").From(gremlingo.T__.Select("u")).ToList()
-		})
+		}, loadbalancer.WithNoRetry())
 		_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
 			return g.V().Has("userId", "U2").As("u").V().Has("accountId", "A2").
 				AddE("owns").From(gremlingo.T__.Select("u")).ToList()
-		})
+		}, loadbalancer.WithNoRetry())
 		_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
 			return g.V().Has("userId", "U3").As("u").V().Has("accountId", "A3").
 				AddE("owns").From(gremlingo.T__.Select("u")).ToList()
-		})
+		}, loadbalancer.WithNoRetry())
 	}
 
 	i := 1
@@ -0,0 +1,193 @@
+package loadbalancer
+
+import (
+	"sync"
+	"time"
+)
+
+// BreakerState is the state of a single host's circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed means the host is taking traffic normally.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen means the host recently failed too often and is
+	// excluded from selection until its cool-down elapses.
+	BreakerOpen
+	// BreakerHalfOpen means the cool-down elapsed and the host is
+	// eligible for exactly one probe traversal to decide whether to
+	// close the breaker again or reopen it.
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "Closed"
+	case BreakerOpen:
+		return "Open"
+	case BreakerHalfOpen:
+		return "HalfOpen"
+	default:
+		return "Unknown"
+	}
+}
+
+// BreakerConfig configures the per-host circuit breaker that guards
+// against a briefly flaky host soaking up traffic between health checks.
+type BreakerConfig struct {
+	// WindowSize is how many of the most recent calls are considered when
+	// evaluating FailureThreshold.
+	WindowSize int
+	// FailureThreshold is the number of failures within the last
+	// WindowSize calls that trips the breaker open. The breaker does not
+	// trip before the window has filled, i.e. before WindowSize calls have
+	// been recorded since it was created or last closed.
+	FailureThreshold int
+	// BaseCooldown is how long the breaker stays open before allowing a
+	// HalfOpen probe the first time it trips.
+	BaseCooldown time.Duration
+	// MaxCooldown caps the cool-down after it grows on repeated
+	// consecutive trips.
+	MaxCooldown time.Duration
+}
+
+// DefaultBreakerConfig returns the BreakerConfig used when none is
+// supplied to NewRoundRobinClientRemoteConnection.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		WindowSize:       10,
+		FailureThreshold: 5,
+		BaseCooldown:     5 * time.Second,
+		MaxCooldown:      2 * time.Minute,
+	}
+}
+
+// breaker is a per-host circuit breaker. A ring buffer of the last
+// WindowSize outcomes decides when to trip open; HalfOpen allows exactly
+// one probe through before deciding whether to close or reopen.
+type breaker struct {
+	cfg BreakerConfig
+
+	mu        sync.Mutex
+	state     BreakerState
+	ring      []bool // true = failure
+	pos       int
+	filled    int
+	failCount int
+	cooldown  time.Duration
+	openUntil time.Time
+	probing   bool
+}
+
+func newBreaker(cfg BreakerConfig) *breaker {
+	return &breaker{
+		cfg:      cfg,
+		ring:     make([]bool, cfg.WindowSize),
+		cooldown: cfg.BaseCooldown,
+	}
+}
+
+// State returns the breaker's current state, lazily transitioning Open to
+// HalfOpen once the cool-down has elapsed.
+func (b *breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.stateLocked()
+}
+
+func (b *breaker) stateLocked() BreakerState {
+	if b.state == BreakerOpen && !b.probing && time.Now().After(b.openUntil) {
+		b.state = BreakerHalfOpen
+	}
+	return b.state
+}
+
+// tryAcquireProbe claims the single HalfOpen probe slot. It returns false
+// if the breaker isn't HalfOpen or a probe is already in flight, in which
+// case the caller should treat the host as unavailable for this dispatch.
+func (b *breaker) tryAcquireProbe() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.stateLocked() != BreakerHalfOpen || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// probeAvailable reports whether this breaker could serve a dispatch right
+// now - Closed, or HalfOpen with no probe already in flight - without
+// claiming anything. Selector candidates are filtered through this before
+// Selector.Pick so a host whose single HalfOpen slot is already taken is
+// never handed to Pick in the first place: Pick's side effects (e.g.
+// LeastOutstandingSelector bumping an outstanding counter) would otherwise
+// be left stranded when the caller then skips that host.
+func (b *breaker) probeAvailable() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state := b.stateLocked()
+	return state != BreakerOpen && !(state == BreakerHalfOpen && b.probing)
+}
+
+func (b *breaker) record(failed bool) {
+	evicted := b.ring[b.pos]
+	if b.filled == len(b.ring) && evicted {
+		b.failCount--
+	}
+	b.ring[b.pos] = failed
+	if failed {
+		b.failCount++
+	}
+	b.pos = (b.pos + 1) % len(b.ring)
+	if b.filled < len(b.ring) {
+		b.filled++
+	}
+}
+
+func (b *breaker) resetRing() {
+	for i := range b.ring {
+		b.ring[i] = false
+	}
+	b.pos, b.filled, b.failCount = 0, 0, 0
+}
+
+// onResult reports the outcome of a call dispatched to this host and
+// returns the resulting state plus whether the state actually changed
+// (so the caller can decide whether a HealthEvent is worth publishing).
+func (b *breaker) onResult(failed bool) (state BreakerState, changed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	before := b.stateLocked()
+	switch before {
+	case BreakerHalfOpen:
+		b.probing = false
+		if failed {
+			b.cooldown = minDuration(b.cooldown*2, b.cfg.MaxCooldown)
+			b.state = BreakerOpen
+			b.openUntil = time.Now().Add(b.cooldown)
+		} else {
+			b.state = BreakerClosed
+			b.cooldown = b.cfg.BaseCooldown
+			b.resetRing()
+		}
+	case BreakerClosed:
+		b.record(failed)
+		if b.filled == len(b.ring) && b.failCount >= b.cfg.FailureThreshold {
+			b.state = BreakerOpen
+			b.openUntil = time.Now().Add(b.cooldown)
+		}
+	case BreakerOpen:
+		// A call landed here via a race with the HalfOpen transition;
+		// its outcome doesn't change anything.
+	}
+	return b.state, b.state != before
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,130 @@
+package loadbalancer
+
+import (
+	"testing"
+	"time"
+)
+
+func testBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		WindowSize:       2,
+		FailureThreshold: 2,
+		BaseCooldown:     20 * time.Millisecond,
+		MaxCooldown:      100 * time.Millisecond,
+	}
+}
+
+func TestBreakerTripsOpenAfterThreshold(t *testing.T) {
+	b := newBreaker(testBreakerConfig())
+
+	if b.State() != BreakerClosed {
+		t.Fatalf("new breaker state = %s, want Closed", b.State())
+	}
+
+	b.onResult(true)
+	if b.State() != BreakerClosed {
+		t.Fatalf("state after 1 failure = %s, want Closed (threshold not reached)", b.State())
+	}
+
+	state, changed := b.onResult(true)
+	if state != BreakerOpen || !changed {
+		t.Fatalf("state after 2 failures = %s (changed=%v), want Open (changed=true)", state, changed)
+	}
+}
+
+func TestBreakerSlidingWindowAgesOutOldFailures(t *testing.T) {
+	cfg := BreakerConfig{WindowSize: 4, FailureThreshold: 3, BaseCooldown: time.Second, MaxCooldown: time.Minute}
+	b := newBreaker(cfg)
+
+	// Two failures fill half the window, then two successes fill the
+	// rest without reaching the threshold.
+	for _, failed := range []bool{true, true, false, false} {
+		if state, _ := b.onResult(failed); state != BreakerClosed {
+			t.Fatalf("unexpected trip while filling the window: state=%s", state)
+		}
+	}
+
+	// Further successes should evict the two old failures out of the
+	// window rather than letting them accumulate forever.
+	for i := 0; i < 2; i++ {
+		if state, _ := b.onResult(false); state != BreakerClosed {
+			t.Fatalf("unexpected trip while aging out old failures: state=%s", state)
+		}
+	}
+	if b.failCount != 0 {
+		t.Fatalf("failCount = %d after the original failures aged out of the window, want 0", b.failCount)
+	}
+}
+
+func TestBreakerHalfOpenAfterCooldown(t *testing.T) {
+	cfg := testBreakerConfig()
+	b := newBreaker(cfg)
+	b.onResult(true)
+	b.onResult(true) // trips open
+
+	if b.State() != BreakerOpen {
+		t.Fatalf("state = %s, want Open", b.State())
+	}
+
+	time.Sleep(cfg.BaseCooldown + 5*time.Millisecond)
+	if b.State() != BreakerHalfOpen {
+		t.Fatalf("state after cooldown = %s, want HalfOpen", b.State())
+	}
+}
+
+func TestBreakerHalfOpenProbeSucceedsCloses(t *testing.T) {
+	cfg := testBreakerConfig()
+	b := newBreaker(cfg)
+	b.onResult(true)
+	b.onResult(true)
+	time.Sleep(cfg.BaseCooldown + 5*time.Millisecond)
+
+	if !b.tryAcquireProbe() {
+		t.Fatalf("expected to acquire the HalfOpen probe slot")
+	}
+	state, changed := b.onResult(false)
+	if state != BreakerClosed || !changed {
+		t.Fatalf("state after successful probe = %s (changed=%v), want Closed (changed=true)", state, changed)
+	}
+}
+
+func TestBreakerHalfOpenProbeFailsReopensWithLongerCooldown(t *testing.T) {
+	cfg := testBreakerConfig()
+	b := newBreaker(cfg)
+	b.onResult(true)
+	b.onResult(true)
+	time.Sleep(cfg.BaseCooldown + 5*time.Millisecond)
+
+	if !b.tryAcquireProbe() {
+		t.Fatalf("expected to acquire the HalfOpen probe slot")
+	}
+	state, changed := b.onResult(true)
+	if state != BreakerOpen || !changed {
+		t.Fatalf("state after failed probe = %s (changed=%v), want Open (changed=true)", state, changed)
+	}
+
+	b.mu.Lock()
+	cooldown := b.cooldown
+	b.mu.Unlock()
+	if cooldown <= cfg.BaseCooldown {
+		t.Fatalf("cooldown after a repeat trip = %s, want > base cooldown %s", cooldown, cfg.BaseCooldown)
+	}
+}
+
+func TestBreakerOnlyOneProbeSlotAtATime(t *testing.T) {
+	cfg := testBreakerConfig()
+	b := newBreaker(cfg)
+	b.onResult(true)
+	b.onResult(true)
+	time.Sleep(cfg.BaseCooldown + 5*time.Millisecond)
+
+	if !b.tryAcquireProbe() {
+		t.Fatalf("first tryAcquireProbe should succeed")
+	}
+	if b.tryAcquireProbe() {
+		t.Fatalf("second tryAcquireProbe should fail while a probe is already in flight")
+	}
+	if b.probeAvailable() {
+		t.Fatalf("probeAvailable should be false while a probe is already in flight")
+	}
+}
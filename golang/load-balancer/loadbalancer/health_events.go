@@ -0,0 +1,44 @@
+package loadbalancer
+
+import "time"
+
+// HealthEventType identifies what happened to a host.
+type HealthEventType int
+
+const (
+	// HostUp is emitted when a host transitions from unavailable to
+	// available, whether observed by the health loop or by a successful
+	// DoIter/DoList call reviving a host early.
+	HostUp HealthEventType = iota
+	// HostDown is emitted when a host transitions from available to
+	// unavailable.
+	HostDown
+	// HostAdded is emitted when AddHost registers a new host.
+	HostAdded
+	// HostRemoved is emitted when RemoveHost unregisters a host.
+	HostRemoved
+)
+
+func (t HealthEventType) String() string {
+	switch t {
+	case HostUp:
+		return "HostUp"
+	case HostDown:
+		return "HostDown"
+	case HostAdded:
+		return "HostAdded"
+	case HostRemoved:
+		return "HostRemoved"
+	default:
+		return "Unknown"
+	}
+}
+
+// HealthEvent describes a single host state transition, letting callers
+// wire up metrics or alerts without scraping log lines.
+type HealthEvent struct {
+	Type    HealthEventType
+	Address string
+	Index   int
+	Time    time.Time
+}
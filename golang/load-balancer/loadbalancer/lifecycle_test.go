@@ -0,0 +1,57 @@
+package loadbalancer
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"testing"
+	"time"
+)
+
+func newTestLB(t *testing.T) *RoundRobinClientRemoteConnection {
+	t.Helper()
+	logger := log.New(&bytes.Buffer{}, "", 0)
+	// These endpoints have nothing listening; NewRoundRobinClientRemoteConnection
+	// tolerates that by marking the host down rather than failing, which is
+	// all this test needs - it never dispatches a real traversal.
+	return NewRoundRobinClientRemoteConnection([]string{"127.0.0.1:1"}, "g", time.Hour, logger)
+}
+
+func TestPublishAfterStopDoesNotPanic(t *testing.T) {
+	lb := newTestLB(t)
+	if err := lb.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := lb.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("publish after Stop panicked: %v", r)
+		}
+	}()
+	lb.publish(HealthEvent{Type: HostDown, Address: "ws://127.0.0.1:1/gremlin", Index: 0, Time: time.Now()})
+}
+
+func TestStopIsIdempotentAndConcurrencySafe(t *testing.T) {
+	lb := newTestLB(t)
+	if err := lb.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lb.AddHost("127.0.0.1:2", "g")
+		lb.RemoveHost("127.0.0.1:2")
+	}()
+
+	if err := lb.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if err := lb.Stop(); err != nil {
+		t.Fatalf("second Stop: %v", err)
+	}
+	<-done
+}
@@ -4,7 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
+	"math/rand"
+	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,32 +19,172 @@ import (
 
 var ErrNoHealthyHosts = errors.New("no healthy Gremlin hosts available")
 
+// ErrAttemptTimeout wraps the error returned when a single attempt exceeds
+// RetryPolicy.PerAttemptTimeout, so callers (and defaultIsRetryable) can
+// recognize it with errors.Is regardless of the human-readable message.
+var ErrAttemptTimeout = errors.New("traversal attempt timed out")
+
 type rrEntry struct {
 	conn    *gremlingo.DriverRemoteConnection
 	address string
 }
 
+// RetryPolicy configures the transparent retry-with-failover behavior of
+// DoIter and DoList. When a traversal fails on the host it was dispatched
+// to, the policy decides whether the error is worth retrying at all and,
+// if so, how many times and with what backoff before the caller gives up.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of dispatch attempts, including the
+	// first one. A value <= 1 disables retries entirely.
+	MaxAttempts int
+	// BaseDelay is the upper bound of the backoff before the second
+	// attempt; the bound doubles on each subsequent attempt up to MaxDelay,
+	// and the actual delay is drawn uniformly between zero and that bound.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay regardless of attempt count.
+	MaxDelay time.Duration
+	// PerAttemptTimeout bounds how long a single attempt may take before
+	// it is treated as a failure and (if retryable) retried on another
+	// host. Zero disables the timeout.
+	PerAttemptTimeout time.Duration
+	// IsRetryable decides whether an error from a given attempt should be
+	// retried on another host. It should return true only for transient,
+	// non-deterministic errors (e.g. a dropped websocket) and false for
+	// errors that will fail identically on any host (e.g. a Gremlin
+	// script/compile error), since retrying those just wastes attempts.
+	IsRetryable func(err error) bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when none is supplied to
+// NewRoundRobinClientRemoteConnection.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       3,
+		BaseDelay:         100 * time.Millisecond,
+		MaxDelay:          2 * time.Second,
+		PerAttemptTimeout: 10 * time.Second,
+		IsRetryable:       defaultIsRetryable,
+	}
+}
+
+// defaultIsRetryable treats connection-level failures (dropped websockets,
+// resets, timeouts) as retryable and leaves everything else - notably
+// Gremlin script/compile errors, which are deterministic and would fail on
+// every host identically - alone.
+func defaultIsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, ErrAttemptTimeout) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, needle := range []string{"websocket", "closed network", "connection reset", "broken pipe", "eof", "i/o timeout"} {
+		if strings.Contains(msg, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoffDelay computes the exponential backoff (with full jitter) used
+// before the given retry attempt. attempt is 1-based and refers to the
+// attempt that just failed.
+func backoffDelay(policy RetryPolicy, attempt int) time.Duration {
+	if policy.BaseDelay <= 0 {
+		return 0
+	}
+	delay := float64(policy.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if policy.MaxDelay > 0 && delay > float64(policy.MaxDelay) {
+		delay = float64(policy.MaxDelay)
+	}
+	return time.Duration(rand.Float64() * delay)
+}
+
+// doConfig holds the per-call options applied by DoOption.
+type doConfig struct {
+	noRetry bool
+}
+
+// DoOption customizes a single DoIter/DoList call.
+type DoOption func(*doConfig)
+
+// WithNoRetry disables retry-with-failover for a single DoIter/DoList call.
+// Non-idempotent mutations (e.g. AddV/AddE) should pass this, since a retry
+// re-invokes the caller's closure and would otherwise risk double-applying
+// the mutation if the first attempt actually succeeded server-side but the
+// response was lost.
+func WithNoRetry() DoOption {
+	return func(c *doConfig) { c.noRetry = true }
+}
+
+// Option customizes a RoundRobinClientRemoteConnection at construction
+// time.
+type Option func(*RoundRobinClientRemoteConnection)
+
+// WithSelector overrides the host-selection strategy. The default is
+// RoundRobinSelector, preserving the load-balancer's original behavior.
+func WithSelector(selector Selector) Option {
+	return func(lb *RoundRobinClientRemoteConnection) { lb.selector = selector }
+}
+
+// WithRetryPolicy overrides the retry policy applied to every
+// DoIter/DoList call that doesn't opt out via WithNoRetry(). The default
+// is DefaultRetryPolicy().
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(lb *RoundRobinClientRemoteConnection) { lb.retryPolicy = policy }
+}
+
+// WithBreakerConfig overrides the per-host circuit breaker configuration.
+// The default is DefaultBreakerConfig().
+func WithBreakerConfig(cfg BreakerConfig) Option {
+	return func(lb *RoundRobinClientRemoteConnection) { lb.breakerCfg = cfg }
+}
+
 type RoundRobinClientRemoteConnection struct {
-	logger      *log.Logger
-	pos         uint64
-	healthEvery time.Duration
+	logger          *log.Logger
+	selector        Selector
+	healthEvery     time.Duration
+	retryPolicy     RetryPolicy
+	breakerCfg      BreakerConfig
+	traversalSource string
 
 	mu        sync.Mutex
 	entries   []*rrEntry
 	available []atomic.Bool
+	breakers  []*breaker
+
+	events chan HealthEvent
 
-	stopCh chan struct{}
-	wg     sync.WaitGroup
+	startOnce sync.Once
+	stopOnce  sync.Once
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	stopped   atomic.Bool
 }
 
-func NewRoundRobinClientRemoteConnection(endpoints []string, traversalSource string, healthCheckInterval time.Duration, logger *log.Logger) *RoundRobinClientRemoteConnection {
+// NewRoundRobinClientRemoteConnection builds a load-balancer over endpoints,
+// eagerly opening a connection to each. It does not start the background
+// health loop - call Start once the caller is ready to run it.
+func NewRoundRobinClientRemoteConnection(endpoints []string, traversalSource string, healthCheckInterval time.Duration, logger *log.Logger, opts ...Option) *RoundRobinClientRemoteConnection {
 	if logger == nil {
 		logger = log.Default()
 	}
 	lb := &RoundRobinClientRemoteConnection{
-		logger:      logger,
-		healthEvery: healthCheckInterval,
-		stopCh:      make(chan struct{}),
+		logger:          logger,
+		selector:        NewRoundRobinSelector(),
+		healthEvery:     healthCheckInterval,
+		retryPolicy:     DefaultRetryPolicy(),
+		breakerCfg:      DefaultBreakerConfig(),
+		traversalSource: traversalSource,
+		events:          make(chan HealthEvent, 64),
+	}
+	for _, opt := range opts {
+		opt(lb)
 	}
 	for _, ep := range endpoints {
 		ws := fmt.Sprintf("ws://%s/gremlin", ep)
@@ -52,30 +197,145 @@ func NewRoundRobinClientRemoteConnection(endpoints []string, traversalSource str
 			var b atomic.Bool
 			b.Store(false)
 			lb.available = append(lb.available, b)
+			lb.breakers = append(lb.breakers, newBreaker(lb.breakerCfg))
 			continue
 		}
 		lb.entries = append(lb.entries, &rrEntry{conn: drc, address: ws})
 		var b atomic.Bool
 		b.Store(true)
 		lb.available = append(lb.available, b)
+		lb.breakers = append(lb.breakers, newBreaker(lb.breakerCfg))
 	}
-	lb.wg.Add(1)
-	go lb.healthLoop(traversalSource)
 	lb.logger.Printf("Initialized load-balancer with endpoints: %v", endpoints)
 	return lb
 }
 
-func (lb *RoundRobinClientRemoteConnection) Close() {
-	close(lb.stopCh)
-	lb.wg.Wait()
+// SetRetryPolicy replaces the retry policy used by DoIter/DoList going
+// forward. It is safe to call while traversals are in flight.
+func (lb *RoundRobinClientRemoteConnection) SetRetryPolicy(policy RetryPolicy) {
 	lb.mu.Lock()
 	defer lb.mu.Unlock()
-	for _, e := range lb.entries {
-		if e.conn != nil {
-			e.conn.Close()
+	lb.retryPolicy = policy
+}
+
+// Start launches the background health loop, deriving its lifetime from
+// ctx so callers get prompt shutdown by canceling ctx or calling Stop.
+// Start is idempotent: only the first call has any effect.
+func (lb *RoundRobinClientRemoteConnection) Start(ctx context.Context) error {
+	lb.startOnce.Do(func() {
+		runCtx, cancel := context.WithCancel(ctx)
+		lb.cancel = cancel
+		lb.wg.Add(1)
+		go lb.healthLoop(runCtx)
+	})
+	return nil
+}
+
+// Stop halts the health loop and closes every underlying connection. Stop
+// is idempotent: calling it more than once, or before Start, is safe.
+func (lb *RoundRobinClientRemoteConnection) Stop() error {
+	lb.stopOnce.Do(func() {
+		lb.stopped.Store(true)
+		if lb.cancel != nil {
+			lb.cancel()
+		}
+		lb.wg.Wait()
+		lb.mu.Lock()
+		for _, e := range lb.entries {
+			if e.conn != nil {
+				e.conn.Close()
+			}
 		}
+		lb.mu.Unlock()
+		lb.logger.Printf("Load-balancer shut down")
+	})
+	return nil
+}
+
+// Subscribe returns a channel of HealthEvents describing host state
+// transitions (HostUp/HostDown/HostAdded/HostRemoved), letting callers
+// plug in metrics or alerts without scraping log lines. The channel is
+// never closed - AddHost, RemoveHost, and in-flight DoIter/DoList calls
+// can all publish concurrently with Stop, so callers should instead learn
+// of shutdown from Stop returning (or their own context being canceled).
+// Slow subscribers drop events rather than blocking the load-balancer.
+func (lb *RoundRobinClientRemoteConnection) Subscribe() <-chan HealthEvent {
+	return lb.events
+}
+
+func (lb *RoundRobinClientRemoteConnection) publish(evt HealthEvent) {
+	if lb.stopped.Load() {
+		return
+	}
+	select {
+	case lb.events <- evt:
+	default:
+		lb.logger.Printf("dropped %s event for %s: subscriber not keeping up", evt.Type, evt.Address)
 	}
-	lb.logger.Printf("Load-balancer shut down")
+}
+
+// indexOfLocked returns the current index of addr among lb.entries, or -1
+// if no such host is registered. Callers must hold lb.mu.
+func (lb *RoundRobinClientRemoteConnection) indexOfLocked(addr string) int {
+	for i, e := range lb.entries {
+		if e.address == addr {
+			return i
+		}
+	}
+	return -1
+}
+
+// markAvailableByAddress sets the availability of the host at addr and, if
+// that changed its state, publishes a HostUp/HostDown event. addr is
+// re-resolved to its current index under lb.mu so a caller (the health
+// loop) that has been probing for a while can still update the right slot
+// even if AddHost or RemoveHost shifted indexes in the meantime.
+func (lb *RoundRobinClientRemoteConnection) markAvailableByAddress(addr string, available bool) {
+	lb.mu.Lock()
+	idx := lb.indexOfLocked(addr)
+	if idx < 0 {
+		lb.mu.Unlock()
+		return
+	}
+	changed := lb.available[idx].Swap(available) != available
+	lb.mu.Unlock()
+	if !changed {
+		return
+	}
+	evtType := HostDown
+	if available {
+		evtType = HostUp
+	}
+	lb.publish(HealthEvent{Type: evtType, Address: addr, Index: idx, Time: time.Now()})
+}
+
+// recordBreakerOutcome reports whether the call dispatched to idx failed
+// and, if that flipped the host's circuit breaker between Closed/Open/
+// HalfOpen, publishes a HealthEvent so Subscribe() callers see the
+// transition without needing to poll GetBreakerStates. Unlike
+// markAvailableByAddress, a single failure only counts against the
+// breaker's window - it takes FailureThreshold failures within WindowSize
+// calls to pull a host out.
+func (lb *RoundRobinClientRemoteConnection) recordBreakerOutcome(idx int, failed bool) {
+	lb.mu.Lock()
+	if idx < 0 || idx >= len(lb.breakers) {
+		lb.mu.Unlock()
+		return
+	}
+	b := lb.breakers[idx]
+	addr := lb.entries[idx].address
+	lb.mu.Unlock()
+
+	state, changed := b.onResult(failed)
+	if !changed {
+		return
+	}
+	evtType := HostDown
+	if state == BreakerClosed {
+		evtType = HostUp
+	}
+	lb.logger.Printf("Connection #%d circuit breaker %s -> %s", idx, addr, state)
+	lb.publish(HealthEvent{Type: evtType, Address: addr, Index: idx, Time: time.Now()})
 }
 
 func (lb *RoundRobinClientRemoteConnection) AddHost(endpoint, traversalSource string) {
@@ -84,7 +344,6 @@ func (lb *RoundRobinClientRemoteConnection) AddHost(endpoint, traversalSource st
 		s.TraversalSource = traversalSource
 	})
 	lb.mu.Lock()
-	defer lb.mu.Unlock()
 	var b atomic.Bool
 	if err != nil {
 		lb.logger.Printf("AddHost failed to open %s: %v (marking down)", ws, err)
@@ -95,22 +354,21 @@ func (lb *RoundRobinClientRemoteConnection) AddHost(endpoint, traversalSource st
 		lb.entries = append(lb.entries, &rrEntry{conn: drc, address: ws})
 	}
 	lb.available = append(lb.available, b)
+	lb.breakers = append(lb.breakers, newBreaker(lb.breakerCfg))
+	idx := len(lb.entries) - 1
+	lb.mu.Unlock()
+
 	lb.logger.Printf("Added host %s", endpoint)
+	lb.publish(HealthEvent{Type: HostAdded, Address: ws, Index: idx, Time: time.Now()})
 }
 
 func (lb *RoundRobinClientRemoteConnection) RemoveHost(endpoint string) {
 	ws := fmt.Sprintf("ws://%s/gremlin", endpoint)
 	lb.mu.Lock()
-	defer lb.mu.Unlock()
 
-	idx := -1
-	for i, e := range lb.entries {
-		if e.address == ws {
-			idx = i
-			break
-		}
-	}
+	idx := lb.indexOfLocked(ws)
 	if idx < 0 {
+		lb.mu.Unlock()
 		lb.logger.Printf("Tried to remove non-existent host %s", endpoint)
 		return
 	}
@@ -119,13 +377,21 @@ func (lb *RoundRobinClientRemoteConnection) RemoveHost(endpoint string) {
 	}
 	lb.entries = append(lb.entries[:idx], lb.entries[idx+1:]...)
 	lb.available = append(lb.available[:idx], lb.available[idx+1:]...)
+	lb.breakers = append(lb.breakers[:idx], lb.breakers[idx+1:]...)
+	lb.mu.Unlock()
+
 	lb.logger.Printf("Removed host %s", endpoint)
+	lb.publish(HealthEvent{Type: HostRemoved, Address: ws, Index: idx, Time: time.Now()})
 }
 
+// healthyIndexes returns hosts that are up (per the health loop) and whose
+// circuit breaker isn't Open. HalfOpen hosts are included so a probe
+// traversal can reach them; withRemoteExcluding gates that down to exactly
+// one in-flight probe.
 func (lb *RoundRobinClientRemoteConnection) healthyIndexes() []int {
 	out := make([]int, 0, len(lb.entries))
 	for i := range lb.entries {
-		if lb.available[i].Load() && lb.entries[i].conn != nil {
+		if lb.available[i].Load() && lb.entries[i].conn != nil && lb.breakers[i].State() != BreakerOpen {
 			out = append(out, i)
 		}
 	}
@@ -133,6 +399,26 @@ func (lb *RoundRobinClientRemoteConnection) healthyIndexes() []int {
 }
 
 func (lb *RoundRobinClientRemoteConnection) WithRemote() (*gremlingo.GraphTraversalSource, int, error) {
+	return lb.withRemoteExcluding(nil)
+}
+
+// withRemoteExcluding asks the configured Selector for the next healthy
+// host, preferring hosts not present in excluded (used by the retry path
+// in DoIter/DoList to avoid re-dispatching to a host that just failed). If
+// every healthy host is excluded, it falls back to the full healthy set.
+//
+// A HalfOpen host is only ever handed out to a single caller at a time:
+// candidates whose probe slot is already taken are filtered out before the
+// Selector is consulted at all, so a losing candidate never reaches
+// Selector.Pick and leaves behind a stranded side effect (e.g.
+// LeastOutstandingSelector's outstanding counter). The tryAcquireProbe
+// recheck below can't actually lose a race today - withRemoteExcluding
+// holds lb.mu for its whole body and tryAcquireProbe is only ever called
+// from here, so nothing can claim a probe slot between the filter and the
+// pick - but it's kept as defense-in-depth against a future change that
+// calls tryAcquireProbe (or drops lb.mu) elsewhere; it excludes the
+// surprised host and asks the Selector again, up to once per candidate.
+func (lb *RoundRobinClientRemoteConnection) withRemoteExcluding(excluded map[int]bool) (*gremlingo.GraphTraversalSource, int, error) {
 	lb.mu.Lock()
 	defer lb.mu.Unlock()
 
@@ -140,98 +426,301 @@ func (lb *RoundRobinClientRemoteConnection) WithRemote() (*gremlingo.GraphTraver
 	if len(healthy) == 0 {
 		return nil, -1, ErrNoHealthyHosts
 	}
-	pos := int(atomic.AddUint64(&lb.pos, 1)-1) % len(healthy)
-	idx := healthy[pos]
-	conn := lb.entries[idx].conn
 
-	lb.logger.Printf("Traversal submitted via connection #%d", idx)
-	g := gremlingo.Traversal_().WithRemote(conn)
-	return g, idx, nil
+	skip := excluded
+	for attempts := 0; attempts < len(healthy); attempts++ {
+		candidates := healthy
+		if len(skip) > 0 {
+			filtered := make([]int, 0, len(healthy))
+			for _, idx := range healthy {
+				if !skip[idx] {
+					filtered = append(filtered, idx)
+				}
+			}
+			if len(filtered) > 0 {
+				candidates = filtered
+			}
+		}
+
+		eligible := make([]int, 0, len(candidates))
+		for _, idx := range candidates {
+			if lb.breakers[idx].probeAvailable() {
+				eligible = append(eligible, idx)
+			}
+		}
+		if len(eligible) == 0 {
+			// Every remaining candidate is a HalfOpen host whose single
+			// probe slot is already taken elsewhere - nothing here can
+			// serve this dispatch.
+			break
+		}
+		candidates = eligible
+
+		idx := lb.selector.Pick(candidates)
+
+		if lb.breakers[idx].State() == BreakerHalfOpen && !lb.breakers[idx].tryAcquireProbe() {
+			if skip == nil {
+				skip = make(map[int]bool, len(healthy))
+			}
+			skip[idx] = true
+			continue
+		}
+
+		conn := lb.entries[idx].conn
+		lb.logger.Printf("Traversal submitted via connection #%d", idx)
+		g := gremlingo.Traversal_().WithRemote(conn)
+		return g, idx, nil
+	}
+	return nil, -1, ErrNoHealthyHosts
+}
+
+// drainErrCh fully drains errCh - regardless of timeout - so that the
+// goroutine backing the traversal's Iterate() never blocks on a send after
+// DoIter has moved on, returning the first non-nil error seen (or a timeout
+// error if the drain did not finish within the policy's PerAttemptTimeout).
+func drainErrCh(errCh <-chan error, timeout time.Duration) error {
+	if timeout <= 0 {
+		var firstErr error
+		for e := range errCh {
+			if e != nil && firstErr == nil {
+				firstErr = e
+			}
+		}
+		return firstErr
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		var firstErr error
+		for e := range errCh {
+			if e != nil && firstErr == nil {
+				firstErr = e
+			}
+		}
+		done <- firstErr
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("traversal timed out after %s: %w", timeout, ErrAttemptTimeout)
+	}
+}
+
+// callWithTimeout runs fn and bounds it by timeout, returning a timeout
+// error if it does not complete in time. fn keeps running in the
+// background after a timeout so its underlying request isn't abandoned
+// mid-flight on the connection.
+func callWithTimeout(timeout time.Duration, fn func() ([]*gremlingo.Result, error)) ([]*gremlingo.Result, error) {
+	if timeout <= 0 {
+		return fn()
+	}
+
+	type result struct {
+		rows []*gremlingo.Result
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		rows, err := fn()
+		done <- result{rows, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.rows, r.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("traversal timed out after %s: %w", timeout, ErrAttemptTimeout)
+	}
 }
 
+// DoIter dispatches f to a healthy host and iterates the returned error
+// channel to completion. On a retryable error (per the configured
+// RetryPolicy), it transparently re-dispatches f to the next healthy host
+// with exponential backoff, up to RetryPolicy.MaxAttempts. Because a retry
+// re-invokes f from scratch, callers performing non-idempotent mutations
+// (AddV, AddE, ...) should pass WithNoRetry() to opt out.
 func (lb *RoundRobinClientRemoteConnection) DoIter(
 	f func(g *gremlingo.GraphTraversalSource) <-chan error,
+	opts ...DoOption,
 ) error {
-	g, idx, err := lb.WithRemote()
-	if err != nil {
-		return err
+	cfg := &doConfig{}
+	for _, opt := range opts {
+		opt(cfg)
 	}
 
-	errCh := f(g)
-	var firstErr error
-	for e := range errCh {
-		if e != nil && firstErr == nil {
-			firstErr = e
-		}
+	lb.mu.Lock()
+	policy := lb.retryPolicy
+	lb.mu.Unlock()
+	maxAttempts := policy.MaxAttempts
+	if cfg.noRetry || maxAttempts < 1 {
+		maxAttempts = 1
 	}
 
-	if firstErr != nil {
-		lb.available[idx].Store(false)
-		lb.logger.Printf("Connection #%d failed during Iterate(): %v – marking host down", idx, firstErr)
-		return firstErr
+	excluded := make(map[int]bool, maxAttempts)
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		g, idx, err := lb.withRemoteExcluding(excluded)
+		if err != nil {
+			if lastErr != nil {
+				return lastErr
+			}
+			return err
+		}
+
+		start := time.Now()
+		firstErr := drainErrCh(f(g), policy.PerAttemptTimeout)
+		lb.selector.Observe(idx, time.Since(start), firstErr)
+		lb.recordBreakerOutcome(idx, firstErr != nil)
+		if firstErr == nil {
+			return nil
+		}
+
+		lb.logger.Printf("Connection #%d failed during Iterate(): %v", idx, firstErr)
+		lastErr = firstErr
+
+		if cfg.noRetry || attempt == maxAttempts || !policy.IsRetryable(firstErr) {
+			return lastErr
+		}
+		excluded[idx] = true
+		time.Sleep(backoffDelay(policy, attempt))
 	}
-	return nil
+	return lastErr
 }
 
+// DoList dispatches f to a healthy host and returns its results. It shares
+// DoIter's retry-with-failover semantics - see its doc comment for details,
+// including the WithNoRetry() opt-out for non-idempotent calls.
 func (lb *RoundRobinClientRemoteConnection) DoList(
 	f func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error),
+	opts ...DoOption,
 ) ([]*gremlingo.Result, error) {
-	g, idx, err := lb.WithRemote()
-	if err != nil {
-		return nil, err
+	cfg := &doConfig{}
+	for _, opt := range opts {
+		opt(cfg)
 	}
-	rows, err := f(g)
-	if err != nil {
-		lb.available[idx].Store(false)
-		lb.logger.Printf("Connection #%d failed: %v – marking host down", idx, err)
-		return nil, err
+
+	lb.mu.Lock()
+	policy := lb.retryPolicy
+	lb.mu.Unlock()
+	maxAttempts := policy.MaxAttempts
+	if cfg.noRetry || maxAttempts < 1 {
+		maxAttempts = 1
 	}
-	return rows, nil
+
+	excluded := make(map[int]bool, maxAttempts)
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		g, idx, err := lb.withRemoteExcluding(excluded)
+		if err != nil {
+			if lastErr != nil {
+				return nil, lastErr
+			}
+			return nil, err
+		}
+
+		start := time.Now()
+		rows, err := callWithTimeout(policy.PerAttemptTimeout, func() ([]*gremlingo.Result, error) {
+			return f(g)
+		})
+		lb.selector.Observe(idx, time.Since(start), err)
+		lb.recordBreakerOutcome(idx, err != nil)
+		if err == nil {
+			return rows, nil
+		}
+
+		lb.logger.Printf("Connection #%d failed: %v", idx, err)
+		lastErr = err
+
+		if cfg.noRetry || attempt == maxAttempts || !policy.IsRetryable(err) {
+			return nil, lastErr
+		}
+		excluded[idx] = true
+		time.Sleep(backoffDelay(policy, attempt))
+	}
+	return nil, lastErr
 }
 
-func (lb *RoundRobinClientRemoteConnection) healthLoop(traversalSource string) {
+func (lb *RoundRobinClientRemoteConnection) healthLoop(ctx context.Context) {
 	defer lb.wg.Done()
 	t := time.NewTicker(lb.healthEvery)
 	defer t.Stop()
 	for {
 		select {
-		case <-lb.stopCh:
+		case <-ctx.Done():
 			return
 		case <-t.C:
 			lb.logger.Printf("Running health check")
-			lb.mu.Lock()
-			entries := lb.entries
-			lb.mu.Unlock()
+			lb.probeAll(ctx)
+		}
+	}
+}
 
-			for i := range entries {
-				if entries[i].conn == nil {
-					drc, err := gremlingo.NewDriverRemoteConnection(entries[i].address, func(s *gremlingo.DriverRemoteConnectionSettings) {
-						s.TraversalSource = traversalSource
-					})
-					if err != nil {
-						lb.available[i].Store(false)
-						lb.logger.Printf("Host #%d reopen failed: %v", i, err)
-						continue
-					}
-					lb.mu.Lock()
-					entries[i].conn = drc
-					lb.mu.Unlock()
-				}
+// probeAll snapshots the current host addresses and probes each in turn.
+// Probing by address rather than by index means a host added or removed by
+// AddHost/RemoveHost while a probe is in flight can't corrupt an unrelated
+// slot: probeHost re-resolves the address's current index right before it
+// mutates any shared state.
+func (lb *RoundRobinClientRemoteConnection) probeAll(ctx context.Context) {
+	lb.mu.Lock()
+	addresses := make([]string, len(lb.entries))
+	for i, e := range lb.entries {
+		addresses[i] = e.address
+	}
+	lb.mu.Unlock()
 
-				_, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-				g := gremlingo.Traversal_().WithRemote(entries[i].conn)
-				_, err := g.V().Limit(1).ToList()
-				cancel()
-
-				if err == nil {
-					lb.available[i].Store(true)
-					lb.logger.Printf("Host #%d is healthy", i)
-				} else {
-					lb.available[i].Store(false)
-					lb.logger.Printf("Host #%d still down", i)
-				}
-			}
+	for _, addr := range addresses {
+		if ctx.Err() != nil {
+			return
+		}
+		lb.probeHost(ctx, addr)
+	}
+}
+
+func (lb *RoundRobinClientRemoteConnection) probeHost(ctx context.Context, addr string) {
+	lb.mu.Lock()
+	idx := lb.indexOfLocked(addr)
+	if idx < 0 {
+		lb.mu.Unlock()
+		return // removed since the snapshot was taken
+	}
+	conn := lb.entries[idx].conn
+	traversalSource := lb.traversalSource
+	lb.mu.Unlock()
+
+	if conn == nil {
+		drc, err := gremlingo.NewDriverRemoteConnection(addr, func(s *gremlingo.DriverRemoteConnectionSettings) {
+			s.TraversalSource = traversalSource
+		})
+		if err != nil {
+			lb.logger.Printf("Host %s reopen failed: %v", addr, err)
+			lb.markAvailableByAddress(addr, false)
+			return
+		}
+		lb.mu.Lock()
+		if i := lb.indexOfLocked(addr); i >= 0 {
+			lb.entries[i].conn = drc
+		} else {
+			lb.mu.Unlock()
+			drc.Close() // removed while we were reconnecting
+			return
 		}
+		lb.mu.Unlock()
+		conn = drc
+	}
+
+	_, cancel := context.WithTimeout(ctx, 3*time.Second)
+	g := gremlingo.Traversal_().WithRemote(conn)
+	_, err := g.V().Limit(1).ToList()
+	cancel()
+
+	if err == nil {
+		lb.logger.Printf("Host %s is healthy", addr)
+		lb.markAvailableByAddress(addr, true)
+	} else {
+		lb.logger.Printf("Host %s still down: %v", addr, err)
+		lb.markAvailableByAddress(addr, false)
 	}
 }
 
@@ -255,6 +744,26 @@ func (lb *RoundRobinClientRemoteConnection) GetAvailable() []bool {
 	return out
 }
 
-func (lb *RoundRobinClientRemoteConnection) SetNextIndex(i int) {
-	atomic.StoreUint64(&lb.pos, uint64(i))
+// GetBreakerStates returns the current circuit breaker state of every host,
+// in the same order as GetClients/GetAvailable.
+func (lb *RoundRobinClientRemoteConnection) GetBreakerStates() []BreakerState {
+	lb.mu.Lock()
+	breakers := make([]*breaker, len(lb.breakers))
+	copy(breakers, lb.breakers)
+	lb.mu.Unlock()
+
+	out := make([]BreakerState, len(breakers))
+	for i, b := range breakers {
+		out[i] = b.State()
+	}
+	return out
+}
+
+// Selector returns the host-selection strategy in use. Callers that need
+// selector-specific behavior (e.g. RoundRobinSelector.SetNextIndex for
+// deterministic tests) should type-assert on the result.
+func (lb *RoundRobinClientRemoteConnection) Selector() Selector {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	return lb.selector
 }
@@ -0,0 +1,63 @@
+package loadbalancer
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	gremlingo "github.com/apache/tinkerpop/gremlin-go/v3/driver"
+)
+
+func TestWithRetryPolicyOverridesDefaultMaxAttempts(t *testing.T) {
+	buf := &bytes.Buffer{}
+	lb := newWiringTestLB(t, 2, buf, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	attempts := 0
+	_, err := lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
+		attempts++
+		// A retryable error, so the default policy would retry it.
+		return nil, errors.New("websocket: close sent")
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1 - WithRetryPolicy(MaxAttempts: 1) should disable the 3 attempts DefaultRetryPolicy would otherwise make", attempts)
+	}
+}
+
+func TestWithBreakerConfigOverridesDefaultThreshold(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cfg := BreakerConfig{WindowSize: 1, FailureThreshold: 1, BaseCooldown: time.Minute, MaxCooldown: time.Minute}
+	lb := newWiringTestLB(t, 1, buf, WithBreakerConfig(cfg), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
+		return nil, errors.New("boom")
+	})
+
+	states := lb.GetBreakerStates()
+	if states[0] != BreakerOpen {
+		t.Fatalf("breaker state after a single failure = %s, want Open - DefaultBreakerConfig needs 5 failures in a 10-call window to trip", states[0])
+	}
+}
+
+func TestSetRetryPolicyTakesEffect(t *testing.T) {
+	buf := &bytes.Buffer{}
+	lb := newWiringTestLB(t, 2, buf) // starts on DefaultRetryPolicy (MaxAttempts: 3)
+
+	lb.SetRetryPolicy(RetryPolicy{MaxAttempts: 1})
+
+	attempts := 0
+	_, err := lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
+		attempts++
+		// A retryable error, so the default policy would retry it.
+		return nil, errors.New("websocket: close sent")
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("attempts = %d, want 1 after SetRetryPolicy(MaxAttempts: 1)", attempts)
+	}
+}
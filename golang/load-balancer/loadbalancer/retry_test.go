@@ -0,0 +1,64 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	gremlingo "github.com/apache/tinkerpop/gremlin-go/v3/driver"
+)
+
+func TestDefaultIsRetryable(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"net error", &net.DNSError{Err: "no such host", IsTimeout: true}, true},
+		{"context deadline exceeded", context.DeadlineExceeded, true},
+		{"wrapped context deadline exceeded", fmt.Errorf("submit: %w", context.DeadlineExceeded), true},
+		{"eof", io.EOF, true},
+		{"attempt timeout sentinel", ErrAttemptTimeout, true},
+		{"wrapped attempt timeout", fmt.Errorf("traversal timed out after %s: %w", 10*time.Second, ErrAttemptTimeout), true},
+		{"websocket message", errors.New("websocket: close sent"), true},
+		{"connection reset message", errors.New("read: connection reset by peer"), true},
+		{"gremlin script error", errors.New("gremlin: invalid syntax at line 1"), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := defaultIsRetryable(c.err); got != c.want {
+				t.Fatalf("defaultIsRetryable(%v) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 2 * time.Second}
+
+	if d := backoffDelay(RetryPolicy{}, 1); d != 0 {
+		t.Fatalf("expected zero backoff when BaseDelay is unset, got %s", d)
+	}
+
+	for attempt := 1; attempt <= 6; attempt++ {
+		d := backoffDelay(policy, attempt)
+		if d < 0 || d > policy.MaxDelay {
+			t.Fatalf("attempt %d: backoff %s out of bounds [0, %s]", attempt, d, policy.MaxDelay)
+		}
+	}
+}
+
+func TestCallWithTimeoutWrapsErrAttemptTimeout(t *testing.T) {
+	_, err := callWithTimeout(10*time.Millisecond, func() ([]*gremlingo.Result, error) {
+		time.Sleep(50 * time.Millisecond)
+		return nil, nil
+	})
+	if !errors.Is(err, ErrAttemptTimeout) {
+		t.Fatalf("expected errors.Is(err, ErrAttemptTimeout), got %v", err)
+	}
+}
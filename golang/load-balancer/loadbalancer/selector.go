@@ -0,0 +1,139 @@
+package loadbalancer
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Selector picks which healthy host a traversal should be dispatched to and
+// is told how that traversal turned out, so that load-aware strategies can
+// steer future picks away from slow or failing hosts.
+type Selector interface {
+	// Pick returns one of the indexes in healthy. healthy is never empty
+	// when Pick is called.
+	Pick(healthy []int) int
+	// Observe reports the outcome of a traversal previously dispatched to
+	// idx: how long it took and whether it failed. err is the error
+	// returned by the traversal, or nil on success.
+	Observe(idx int, latency time.Duration, err error)
+}
+
+// RoundRobinSelector cycles through the healthy hosts in order. It is the
+// default Selector, preserving the load-balancer's original behavior.
+type RoundRobinSelector struct {
+	pos uint64
+}
+
+// NewRoundRobinSelector returns a RoundRobinSelector starting at index 0.
+func NewRoundRobinSelector() *RoundRobinSelector {
+	return &RoundRobinSelector{}
+}
+
+func (s *RoundRobinSelector) Pick(healthy []int) int {
+	pos := int(atomic.AddUint64(&s.pos, 1)-1) % len(healthy)
+	return healthy[pos]
+}
+
+func (s *RoundRobinSelector) Observe(idx int, latency time.Duration, err error) {}
+
+// SetNextIndex forces the position of the next Pick, counted against the
+// current healthy set. Primarily useful for deterministic tests.
+func (s *RoundRobinSelector) SetNextIndex(i int) {
+	atomic.StoreUint64(&s.pos, uint64(i))
+}
+
+// LeastOutstandingSelector picks the healthy host with the fewest in-flight
+// traversals, incrementing its count on Pick and decrementing it on
+// Observe.
+type LeastOutstandingSelector struct {
+	mu          sync.Mutex
+	outstanding map[int]*int64
+}
+
+// NewLeastOutstandingSelector returns a LeastOutstandingSelector with no
+// in-flight traversals recorded for any host.
+func NewLeastOutstandingSelector() *LeastOutstandingSelector {
+	return &LeastOutstandingSelector{outstanding: make(map[int]*int64)}
+}
+
+func (s *LeastOutstandingSelector) counter(idx int) *int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.outstanding[idx]
+	if !ok {
+		c = new(int64)
+		s.outstanding[idx] = c
+	}
+	return c
+}
+
+func (s *LeastOutstandingSelector) Pick(healthy []int) int {
+	best := healthy[0]
+	bestLoad := atomic.LoadInt64(s.counter(best))
+	for _, idx := range healthy[1:] {
+		if load := atomic.LoadInt64(s.counter(idx)); load < bestLoad {
+			best, bestLoad = idx, load
+		}
+	}
+	atomic.AddInt64(s.counter(best), 1)
+	return best
+}
+
+func (s *LeastOutstandingSelector) Observe(idx int, latency time.Duration, err error) {
+	atomic.AddInt64(s.counter(idx), -1)
+}
+
+// EWMASelector picks hosts by an exponentially-weighted moving average of
+// observed latency, using power-of-two-choices between two random healthy
+// candidates to avoid herding onto whichever host currently looks fastest.
+type EWMASelector struct {
+	alpha float64
+
+	mu   sync.Mutex
+	ewma map[int]float64
+}
+
+// NewEWMASelector returns an EWMASelector with alpha ~= 0.3.
+func NewEWMASelector() *EWMASelector {
+	return &EWMASelector{alpha: 0.3, ewma: make(map[int]float64)}
+}
+
+func (s *EWMASelector) scoreLocked(idx int) float64 {
+	// Hosts with no observations yet are assumed fast so they get a
+	// chance to prove themselves.
+	return s.ewma[idx]
+}
+
+func (s *EWMASelector) Pick(healthy []int) int {
+	if len(healthy) == 1 {
+		return healthy[0]
+	}
+	i := healthy[rand.Intn(len(healthy))]
+	j := healthy[rand.Intn(len(healthy))]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.scoreLocked(i) <= s.scoreLocked(j) {
+		return i
+	}
+	return j
+}
+
+func (s *EWMASelector) Observe(idx int, latency time.Duration, err error) {
+	if err != nil {
+		// No useful latency signal from a failed call; leave the average
+		// alone rather than skewing it with a bogus sample.
+		return
+	}
+	sample := float64(latency)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.ewma[idx]; ok {
+		s.ewma[idx] = s.alpha*sample + (1-s.alpha)*v
+	} else {
+		s.ewma[idx] = sample
+	}
+}
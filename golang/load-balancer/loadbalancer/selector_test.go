@@ -0,0 +1,172 @@
+package loadbalancer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	gremlingo "github.com/apache/tinkerpop/gremlin-go/v3/driver"
+)
+
+func TestRoundRobinSelectorCyclesInOrder(t *testing.T) {
+	s := NewRoundRobinSelector()
+	healthy := []int{0, 1, 2}
+
+	var got []int
+	for i := 0; i < 5; i++ {
+		got = append(got, s.Pick(healthy))
+	}
+	want := []int{0, 1, 2, 0, 1}
+	for i, idx := range got {
+		if idx != want[i] {
+			t.Fatalf("pick %d = %d, want %d (full sequence %v)", i, idx, want[i], got)
+		}
+	}
+}
+
+func TestRoundRobinSelectorSetNextIndex(t *testing.T) {
+	s := NewRoundRobinSelector()
+	s.SetNextIndex(2)
+	if got := s.Pick([]int{0, 1, 2}); got != 2 {
+		t.Fatalf("Pick() = %d, want 2", got)
+	}
+}
+
+func TestLeastOutstandingSelectorPrefersIdleHost(t *testing.T) {
+	s := NewLeastOutstandingSelector()
+	healthy := []int{0, 1, 2}
+
+	first := s.Pick(healthy)
+	second := s.Pick(healthy)
+	if first == second {
+		t.Fatalf("expected distinct hosts for two outstanding picks, both got %d", first)
+	}
+
+	s.Observe(first, 10*time.Millisecond, nil)
+	s.Observe(second, 10*time.Millisecond, nil)
+
+	// With every host's count back to zero, the pick order should repeat.
+	third := s.Pick(healthy)
+	if third != first {
+		t.Fatalf("Pick() after Observe = %d, want %d (the least-loaded host again)", third, first)
+	}
+}
+
+func TestLeastOutstandingSelectorAvoidsBusyHost(t *testing.T) {
+	s := NewLeastOutstandingSelector()
+	healthy := []int{0, 1}
+
+	busy := s.Pick(healthy) // bump host 0 or 1 to outstanding=1
+	for i := 0; i < 3; i++ {
+		idx := s.Pick(healthy)
+		if idx == busy {
+			t.Fatalf("Pick() returned the still-busy host %d while %d was idle", busy, otherOf(healthy, busy))
+		}
+		s.Observe(idx, time.Millisecond, nil)
+	}
+}
+
+func otherOf(healthy []int, idx int) int {
+	for _, h := range healthy {
+		if h != idx {
+			return h
+		}
+	}
+	return -1
+}
+
+func TestEWMASelectorPrefersFasterHost(t *testing.T) {
+	s := NewEWMASelector()
+	healthy := []int{0, 1}
+
+	// Seed host 0 as fast and host 1 as slow.
+	for i := 0; i < 10; i++ {
+		s.Observe(0, time.Millisecond, nil)
+		s.Observe(1, 100*time.Millisecond, nil)
+	}
+
+	counts := map[int]int{}
+	for i := 0; i < 200; i++ {
+		counts[s.Pick(healthy)]++
+	}
+	if counts[0] <= counts[1] {
+		t.Fatalf("expected the faster host (0) to be picked more often, got counts %v", counts)
+	}
+}
+
+func TestEWMASelectorIgnoresFailedObservations(t *testing.T) {
+	s := NewEWMASelector()
+	s.Observe(0, time.Millisecond, nil)
+	s.mu.Lock()
+	before := s.scoreLocked(0)
+	s.mu.Unlock()
+
+	s.Observe(0, time.Hour, errors.New("probe failed"))
+	s.mu.Lock()
+	after := s.scoreLocked(0)
+	s.mu.Unlock()
+
+	if before != after {
+		t.Fatalf("a failed Observe changed the EWMA score: before=%v after=%v", before, after)
+	}
+}
+
+// newWiringTestLB builds a load-balancer over n endpoints with nothing
+// listening, so the constructor's eager connect attempts fail and every
+// host starts marked down, then force-marks the first n hosts healthy
+// with a placeholder connection. DoIter/DoList/withRemoteExcluding only
+// ever hand that pointer to GraphTraversalSource.WithRemote - they never
+// drive a round trip over it - so as long as a test's own callback
+// doesn't either, this is enough to exercise the load-balancer's Option
+// wiring without a live Gremlin server.
+func newWiringTestLB(t *testing.T, n int, buf *bytes.Buffer, opts ...Option) *RoundRobinClientRemoteConnection {
+	t.Helper()
+	endpoints := make([]string, n)
+	for i := range endpoints {
+		endpoints[i] = fmt.Sprintf("127.0.0.1:%d", i+1)
+	}
+	lb := NewRoundRobinClientRemoteConnection(endpoints, "g", time.Hour, log.New(buf, "", 0), opts...)
+	for i := 0; i < n; i++ {
+		lb.entries[i].conn = &gremlingo.DriverRemoteConnection{}
+		lb.available[i].Store(true)
+	}
+	return lb
+}
+
+// fixedSelector always picks the same index, letting a test prove that
+// the Selector passed to WithSelector - not the default RoundRobinSelector
+// - is the one NewRoundRobinClientRemoteConnection actually dispatches
+// through.
+type fixedSelector struct {
+	idx      int
+	observed []int
+}
+
+func (s *fixedSelector) Pick(healthy []int) int { return s.idx }
+
+func (s *fixedSelector) Observe(idx int, latency time.Duration, err error) {
+	s.observed = append(s.observed, idx)
+}
+
+func TestWithSelectorIsUsedByDispatch(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sel := &fixedSelector{idx: 1}
+	lb := newWiringTestLB(t, 2, buf, WithSelector(sel))
+
+	if _, err := lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatalf("DoList: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "Traversal submitted via connection #1") {
+		t.Fatalf("expected dispatch to connection #1 (fixedSelector's pick) via WithSelector, got log:\n%s", buf.String())
+	}
+	if len(sel.observed) != 1 || sel.observed[0] != 1 {
+		t.Fatalf("expected Observe(1, ...) on the injected selector, got %v", sel.observed)
+	}
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -27,40 +28,44 @@ func setupGraph(t *testing.T, lb *loadbalancer.RoundRobinClientRemoteConnection)
 
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("User").Property("userId", "U1").Property("name", "Alice").Property("age", 30).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("User").Property("userId", "U2").Property("name", "Bob").Property("age", 25).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("User").Property("userId", "U3").Property("name", "Charlie").Property("age", 35).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("Account").Property("accountId", "A1").Property("balance", 1000).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("Account").Property("accountId", "A2").Property("balance", 500).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 		_ = lb.DoIter(func(g *gremlingo.GraphTraversalSource) <-chan error {
 			return g.AddV("Account").Property("accountId", "A3").Property("balance", 750).Iterate()
-		})
+		}, loadbalancer.WithNoRetry())
 
 		_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
 			return g.V().Has("userId", "U1").As("u").V().Has("accountId", "A1").
 				AddE("owns").From(gremlingo.T__.Select("u")).ToList()
-		})
+		}, loadbalancer.WithNoRetry())
 		_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
 			return g.V().Has("userId", "U2").As("u").V().Has("accountId", "A2").
 				AddE("owns").From(gremlingo.T__.Select("u")).ToList()
-		})
+		}, loadbalancer.WithNoRetry())
 		_, _ = lb.DoList(func(g *gremlingo.GraphTraversalSource) ([]*gremlingo.Result, error) {
 			return g.V().Has("userId", "U3").As("u").V().Has("accountId", "A3").
 				AddE("owns").From(gremlingo.T__.Select("u")).ToList()
-		})
+		}, loadbalancer.WithNoRetry())
 	}
 
 	// Start rotation from #0 for deterministic tests
-	lb.SetNextIndex(0)
+	rr, ok := lb.Selector().(*loadbalancer.RoundRobinSelector)
+	if !ok {
+		t.Fatalf("expected default RoundRobinSelector, got %T", lb.Selector())
+	}
+	rr.SetNextIndex(0)
 }
 
 func newLogger(buf *bytes.Buffer) *log.Logger {
@@ -70,7 +75,10 @@ func newLogger(buf *bytes.Buffer) *log.Logger {
 func TestHostAddAndRemoval(t *testing.T) {
 	buf := &bytes.Buffer{}
 	lb := loadbalancer.NewRoundRobinClientRemoteConnection(endpoints, "g", 2*time.Second, newLogger(buf))
-	defer lb.Close()
+	if err := lb.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer lb.Stop()
 
 	initialHosts := lb.GetClients()
 	initialAvail := lb.GetAvailable()
@@ -124,7 +132,10 @@ func TestRotation(t *testing.T) {
 	buf := &bytes.Buffer{}
 	logger := newLogger(buf)
 	lb := loadbalancer.NewRoundRobinClientRemoteConnection(endpoints, "g", 2*time.Second, logger)
-	defer lb.Close()
+	if err := lb.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer lb.Stop()
 
 	setupGraph(t, lb)
 	buf.Reset() // clear seed logs so we count only the loop below
@@ -156,7 +167,10 @@ func TestHealthCheck(t *testing.T) {
 	buf := &bytes.Buffer{}
 	logger := newLogger(buf)
 	lb := loadbalancer.NewRoundRobinClientRemoteConnection(endpoints, "g", 2*time.Second, logger)
-	defer lb.Close()
+	if err := lb.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer lb.Stop()
 
 	setupGraph(t, lb)
 